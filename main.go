@@ -14,20 +14,27 @@ import (
 	"time"
 
 	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	configv1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpandaoperator/config/v1alpha1"
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
 	redpandacontrollers "github.com/redpanda-data/redpanda/src/go/k8s/controllers/redpanda"
 	adminutils "github.com/redpanda-data/redpanda/src/go/k8s/pkg/admin"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/configloader"
 	consolepkg "github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/logging"
 	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/syncjobs"
 	redpandawebhooks "github.com/redpanda-data/redpanda/src/go/k8s/webhooks/redpanda"
+	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crconfigv1alpha1 "sigs.k8s.io/controller-runtime/pkg/config/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
-	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
@@ -60,8 +67,13 @@ func main() {
 		configuratorTag             string
 		configuratorImagePullPolicy string
 		decommissionWaitInterval    time.Duration
+		configFile                  string
+		controllerVLevelFlag        string
 	)
 
+	flag.StringVar(&configFile, "config", "", "Path to a RedpandaOperatorConfiguration file. When set, it takes precedence over the flags below.")
+	flag.StringVar(&controllerVLevelFlag, "controller-v-level", "",
+		"Comma-separated controllerName=vLevel overrides, e.g. 'Cluster=2,Console=4', applied on top of --v.")
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&clusterDomain, "cluster-domain", "cluster.local", "Set the Kubernetes local domain (Kubelet's --cluster-domain)")
@@ -76,24 +88,81 @@ func main() {
 	flag.BoolVar(&redpandav1alpha1.AllowDownscalingInWebhook, "allow-downscaling", false, "Allow to reduce the number of replicas in existing clusters (alpha feature)")
 	flag.BoolVar(&redpandav1alpha1.AllowConsoleAnyNamespace, "allow-console-any-ns", false, "Allow to create Console in any namespace. Allowing this copies Redpanda SchemaRegistry TLS Secret to namespace (alpha feature)")
 
-	opts := zap.Options{
-		Development: true,
+	loggingConfig := logsapi.NewLoggingConfiguration()
+	pflagSet := pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
+	logsapi.AddFlags(loggingConfig, pflagSet)
+	pflagSet.AddGoFlagSet(flag.CommandLine)
+	if err := pflagSet.Parse(os.Args[1:]); err != nil {
+		setupLog.Error(err, "Unable to parse flags")
+		os.Exit(1)
+	}
+
+	if err := logsapi.ValidateAndApply(loggingConfig, nil); err != nil {
+		setupLog.Error(err, "Unable to apply logging configuration")
+		os.Exit(1)
+	}
+	ctrl.SetLogger(klog.Background())
+
+	controllerVLevels, err := logging.ParseControllerVLevels(controllerVLevelFlag)
+	if err != nil {
+		setupLog.Error(err, "Unable to parse --controller-v-level")
+		os.Exit(1)
+	}
+
+	var operatorConfig *configv1alpha1.RedpandaOperatorConfiguration
+	if configFile != "" {
+		var loadErr error
+		operatorConfig, loadErr = configloader.Load(configFile)
+		if loadErr != nil {
+			setupLog.Error(loadErr, "Unable to load operator config", "config", configFile)
+			os.Exit(1)
+		}
+		clusterDomain = operatorConfig.ClusterDomain
+		metricsAddr = operatorConfig.Metrics.BindAddress
+		probeAddr = operatorConfig.Health.HealthProbeBindAddress
+		webhookEnabled = operatorConfig.Webhook.Enabled
+		enableLeaderElection = operatorConfig.LeaderElection.LeaderElect
 	}
 
-	opts.BindFlags(flag.CommandLine)
+	leaderElectionID := "aa9fc693.vectorized.io"
+	leaderElectionNamespace := ""
+	if operatorConfig != nil {
+		leaderElectionID = operatorConfig.LeaderElection.ResourceName
+		leaderElectionNamespace = operatorConfig.LeaderElection.ResourceNamespace
+	}
 
-	flag.Parse()
+	webhookPort := 9443
+	if operatorConfig != nil {
+		webhookPort = operatorConfig.Webhook.Port
+	}
 
-	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	adminAPIClientFactory := adminutils.NewInternalAdminAPI
+	if operatorConfig != nil && operatorConfig.AdminAPIClientFactory == "mock" {
+		adminAPIClientFactory = adminutils.NewMockAdminAPI
+	}
+
+	restConfig := ctrl.GetConfigOrDie()
+	if operatorConfig != nil {
+		restConfig.QPS = operatorConfig.Controller.RateLimit.QPS
+		restConfig.Burst = operatorConfig.Controller.RateLimit.Burst
+	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	managerOpts := ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
-		Port:                   9443,
+		Port:                   webhookPort,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "aa9fc693.vectorized.io",
-	})
+		LeaderElectionID:       leaderElectionID,
+	}
+	managerOpts.LeaderElectionNamespace = leaderElectionNamespace
+	if operatorConfig != nil && len(operatorConfig.Controller.GroupKindConcurrency) > 0 {
+		managerOpts.Controller = crconfigv1alpha1.ControllerConfigurationSpec{
+			GroupKindConcurrency: operatorConfig.Controller.GroupKindConcurrency,
+		}
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, managerOpts)
 	if err != nil {
 		setupLog.Error(err, "Unable to start manager")
 		os.Exit(1)
@@ -107,9 +176,9 @@ func main() {
 
 	if err = (&redpandacontrollers.ClusterReconciler{
 		Client:                   mgr.GetClient(),
-		Log:                      ctrl.Log.WithName("controllers").WithName("redpanda").WithName("Cluster"),
+		Log:                      logging.LoggerFor(ctrl.Log.WithName("controllers").WithName("redpanda"), "Cluster", controllerVLevels),
 		Scheme:                   mgr.GetScheme(),
-		AdminAPIClientFactory:    adminutils.NewInternalAdminAPI,
+		AdminAPIClientFactory:    adminAPIClientFactory,
 		DecommissionWaitInterval: decommissionWaitInterval,
 	}).WithClusterDomain(clusterDomain).WithConfiguratorSettings(configurator).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "Cluster")
@@ -118,9 +187,9 @@ func main() {
 
 	if err = (&redpandacontrollers.ClusterConfigurationDriftReconciler{
 		Client:                mgr.GetClient(),
-		Log:                   ctrl.Log.WithName("controllers").WithName("redpanda").WithName("ClusterConfigurationDrift"),
+		Log:                   logging.LoggerFor(ctrl.Log.WithName("controllers").WithName("redpanda"), "ClusterConfigurationDrift", controllerVLevels),
 		Scheme:                mgr.GetScheme(),
-		AdminAPIClientFactory: adminutils.NewInternalAdminAPI,
+		AdminAPIClientFactory: adminAPIClientFactory,
 	}).WithClusterDomain(clusterDomain).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "ClusterConfigurationDrift")
 		os.Exit(1)
@@ -143,20 +212,56 @@ func main() {
 		hookServer.Register("/validate-redpanda-vectorized-io-v1alpha1-console", &webhook.Admission{Handler: &redpandawebhooks.ConsoleValidator{Client: mgr.GetClient()}})
 	}
 
-	if err = (&redpandacontrollers.ConsoleReconciler{
-		Client:                  mgr.GetClient(),
-		Scheme:                  mgr.GetScheme(),
-		Log:                     ctrl.Log.WithName("controllers").WithName("redpanda").WithName("Console"),
-		AdminAPIClientFactory:   adminutils.NewInternalAdminAPI,
-		Store:                   consolepkg.NewStore(mgr.GetClient()),
-		EventRecorder:           mgr.GetEventRecorderFor("Console"),
-		KafkaAdminClientFactory: consolepkg.NewKafkaAdmin,
-	}).WithClusterDomain(clusterDomain).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Console")
-		os.Exit(1)
+	consoleReconcilerEnabled := true
+	if operatorConfig != nil {
+		if enabled, ok := operatorConfig.FeatureGates["ConsoleReconciler"]; ok {
+			consoleReconcilerEnabled = enabled
+		}
+	}
+
+	if consoleReconcilerEnabled {
+		if err = (&redpandacontrollers.ConsoleReconciler{
+			Client:                  mgr.GetClient(),
+			Scheme:                  mgr.GetScheme(),
+			Log:                     logging.LoggerFor(ctrl.Log.WithName("controllers").WithName("redpanda"), "Console", controllerVLevels),
+			AdminAPIClientFactory:   adminAPIClientFactory,
+			Store:                   consolepkg.NewStore(mgr.GetClient()),
+			EventRecorder:           mgr.GetEventRecorderFor("Console"),
+			KafkaAdminClientFactory: consolepkg.NewKafkaAdmin,
+		}).WithClusterDomain(clusterDomain).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Console")
+			os.Exit(1)
+		}
 	}
 	//+kubebuilder:scaffold:builder
 
+	scheduler := syncjobs.NewScheduler(ctrl.Log.WithName("syncjobs"))
+	scheduler.Register(syncjobs.Job{
+		Name:     syncjobs.PKISyncJob,
+		Interval: 10 * time.Minute,
+		Jitter:   time.Minute,
+		Timeout:  time.Minute,
+		Func:     redpandacontrollers.NewPKISyncJob(mgr.GetClient(), mgr.GetScheme(), clusterDomain, ctrl.Log.WithName("syncjobs").WithName("pki-sync")),
+	})
+	scheduler.Register(syncjobs.Job{
+		Name:     syncjobs.ConsoleACLSyncJob,
+		Interval: 10 * time.Minute,
+		Jitter:   time.Minute,
+		Timeout:  time.Minute,
+		Func:     redpandacontrollers.NewConsoleACLSyncJob(mgr.GetClient(), mgr.GetScheme(), clusterDomain, adminAPIClientFactory, ctrl.Log.WithName("syncjobs").WithName("console-acl-sync")),
+	})
+	scheduler.Register(syncjobs.Job{
+		Name:     syncjobs.NodeCertExpiryCheckJob,
+		Interval: time.Hour,
+		Jitter:   5 * time.Minute,
+		Timeout:  time.Minute,
+		Func:     redpandacontrollers.NewNodeCertExpiryCheckJob(mgr.GetClient(), ctrl.Log.WithName("syncjobs").WithName("node-cert-expiry-check")),
+	})
+	if err = mgr.Add(scheduler); err != nil {
+		setupLog.Error(err, "Unable to add sync job scheduler")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
 		setupLog.Error(err, "Unable to set up health check")
 		os.Exit(1)
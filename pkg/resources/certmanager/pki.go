@@ -90,11 +90,38 @@ func (r *PkiReconciler) AdminCert() types.NamespacedName {
 	return types.NamespacedName{Name: r.pandaCluster.Name + "-" + OperatorClientCert, Namespace: r.pandaCluster.Namespace}
 }
 
+// normalizeIssuerRef defaults the Kind of a user-provided IssuerRef to
+// Issuer, matching cert-manager's own convention for an unset Kind (and the
+// Console reconciler's checkIssuerExists, which resolves the same unset
+// Kind the same way).
+func normalizeIssuerRef(ref *cmmeta.ObjectReference) *cmmeta.ObjectReference {
+	if ref == nil || ref.Kind != "" {
+		return ref
+	}
+	normalized := *ref
+	normalized.Kind = cmmeta.IssuerKind
+	return &normalized
+}
+
+// usesExternalIssuer returns true when every TLS-enabled API (Kafka, Admin)
+// is configured with an external cert-manager Issuer/ClusterIssuer, meaning
+// the operator's self-signed root is not needed for any certificate.
+func (r *PkiReconciler) usesExternalIssuer() bool {
+	tls := r.pandaCluster.Spec.Configuration.TLS
+	if tls.KafkaAPI.Enabled && tls.KafkaAPI.IssuerRef == nil {
+		return false
+	}
+	if tls.AdminAPI.Enabled && tls.AdminAPI.IssuerRef == nil {
+		return false
+	}
+	return tls.KafkaAPI.Enabled || tls.AdminAPI.Enabled
+}
+
 func (r *PkiReconciler) prepareKafkaAPI(
 	selfSignedIssuerRef *cmmeta.ObjectReference,
 ) []resources.Resource {
 	toApply := []resources.Resource{}
-	externalIssuerRef := r.pandaCluster.Spec.Configuration.TLS.KafkaAPI.IssuerRef
+	externalIssuerRef := normalizeIssuerRef(r.pandaCluster.Spec.Configuration.TLS.KafkaAPI.IssuerRef)
 
 	if r.pandaCluster.Spec.Configuration.TLS.KafkaAPI.NodeSecretRef == nil {
 		// Redpanda cluster certificate for Kafka API - to be provided to each broker
@@ -135,6 +162,46 @@ func (r *PkiReconciler) prepareKafkaAPI(
 	return toApply
 }
 
+// prepareAdminAPI mirrors prepareKafkaAPI for the Admin API listener: it
+// issues a node certificate (from an external issuer when one is
+// configured, the self-signed root otherwise) and, when client auth is
+// required, a client certificate for the operator to call the Admin API.
+func (r *PkiReconciler) prepareAdminAPI(
+	selfSignedIssuerRef *cmmeta.ObjectReference,
+) []resources.Resource {
+	toApply := []resources.Resource{}
+	externalIssuerRef := normalizeIssuerRef(r.pandaCluster.Spec.Configuration.TLS.AdminAPI.IssuerRef)
+
+	if r.pandaCluster.Spec.Configuration.TLS.AdminAPI.NodeSecretRef == nil {
+		certsKey := r.certNamespacedName(AdminAPINodeCert)
+		nodeIssuerRef := selfSignedIssuerRef
+		if externalIssuerRef != nil {
+			// if external issuer is provided, we will use it to generate node certificates
+			nodeIssuerRef = externalIssuerRef
+		}
+
+		dnsName := r.internalFQDN
+		externConn := r.pandaCluster.Spec.ExternalConnectivity
+		if externConn.Enabled && externConn.Subdomain != "" {
+			dnsName = externConn.Subdomain
+		}
+
+		adminAPICert := NewCertificate(r.Client, r.scheme, r.pandaCluster, certsKey, nodeIssuerRef, dnsName, false, true, r.logger)
+
+		toApply = append(toApply, adminAPICert)
+	}
+
+	if r.pandaCluster.Spec.Configuration.TLS.AdminAPI.RequireClientAuth {
+		// Certificate for the operator to call the Admin API on any broker in this Redpanda cluster
+		certsKey := r.certNamespacedName(AdminAPIClientCert)
+		adminAPIClientCert := NewCertificate(r.Client, r.scheme, r.pandaCluster, certsKey, selfSignedIssuerRef, "", false, false, r.logger)
+
+		toApply = append(toApply, adminAPIClientCert)
+	}
+
+	return toApply
+}
+
 func (r *PkiReconciler) prepareRoot() (
 	[]resources.Resource,
 	*cmmeta.ObjectReference,
@@ -182,7 +249,15 @@ func (r *PkiReconciler) Ensure(ctx context.Context) error {
 		return nil
 	}
 
-	toApply, selfSignedIssuerRef := r.prepareRoot()
+	var toApply []resources.Resource
+	var selfSignedIssuerRef *cmmeta.ObjectReference
+	if !r.usesExternalIssuer() ||
+		r.pandaCluster.Spec.Configuration.TLS.KafkaAPI.RequireClientAuth ||
+		r.pandaCluster.Spec.Configuration.TLS.AdminAPI.RequireClientAuth {
+		// The self-signed root is still needed to mint client certificates,
+		// or because at least one of Kafka/Admin API has no external issuer.
+		toApply, selfSignedIssuerRef = r.prepareRoot()
+	}
 
 	if r.pandaCluster.Spec.Configuration.TLS.KafkaAPI.Enabled {
 		toApply = append(toApply, r.prepareKafkaAPI(selfSignedIssuerRef)...)
@@ -0,0 +1,247 @@
+// Copyright 2021 Vectorized, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package certmanager
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	redpandav1alpha1 "github.com/vectorizedio/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// RotationAnnotation is set on the StatefulSet's pod template to force a
+	// rolling restart when a watched certificate's serial number changes.
+	RotationAnnotation = "redpanda.vectorized.io/certificate-rotated-at"
+
+	// CertificateRotationInProgress is the Condition type set to True on the
+	// Cluster status when a certificate rotation triggers a rolling restart.
+	// CheckRotation does not set it back to False when the restart completes;
+	// it changes again only on the next observed rotation.
+	CertificateRotationInProgress = "CertificateRotationInProgress"
+
+	// minTimeBetweenRestarts guards against a flapping cert-manager renewal
+	// (or a watch hiccup) triggering back-to-back rolling restarts.
+	minTimeBetweenRestarts = 5 * time.Minute
+
+	// CertificateExpiringSoon is the Condition type surfaced on the Cluster
+	// status when a watched certificate's NotAfter falls within
+	// DefaultExpiryWarningWindow.
+	CertificateExpiringSoon = "CertificateExpiringSoon"
+
+	// DefaultExpiryWarningWindow is how far in advance of a certificate's
+	// NotAfter CheckExpiry reports it as expiring soon.
+	DefaultExpiryWarningWindow = 30 * 24 * time.Hour
+)
+
+// RotationPolicy controls how the operator reacts to a renewed certificate.
+type RotationPolicy string
+
+const (
+	// RotationPolicyAutomatic rolls the StatefulSet as soon as a watched
+	// Secret's certificate changes.
+	RotationPolicyAutomatic RotationPolicy = "Automatic"
+	// RotationPolicyManual records the new certificate in status but leaves
+	// restarting the brokers to the operator's user.
+	RotationPolicyManual RotationPolicy = "Manual"
+	// RotationPolicyDisabled turns off rotation handling entirely.
+	RotationPolicyDisabled RotationPolicy = "Disabled"
+)
+
+// observedCertificate is the serial/notAfter pair recorded for a watched
+// Secret so future reconciles can detect a change.
+type observedCertificate struct {
+	Serial   string
+	NotAfter time.Time
+}
+
+// CheckRotation inspects the Secrets backing the node, operator client, and
+// admin certificates for a change in the served certificate, records the
+// result in the Cluster status, and - when RotationPolicy is Automatic -
+// triggers a rolling restart by bumping RotationAnnotation on the
+// StatefulSet's pod template.
+func (r *PkiReconciler) CheckRotation(ctx context.Context) error {
+	policy := r.pandaCluster.Spec.TLS.RotationPolicy
+	if policy == RotationPolicyDisabled {
+		return nil
+	}
+
+	watched := []types.NamespacedName{r.NodeCert(), r.OperatorClientCert(), r.AdminCert()}
+
+	rotated := false
+	statusChanged := false
+	for _, key := range watched {
+		changed, recorded, err := r.certificateChanged(ctx, key)
+		if err != nil {
+			return fmt.Errorf("checking certificate rotation for %s: %w", key, err)
+		}
+		if changed {
+			rotated = true
+		}
+		if recorded {
+			statusChanged = true
+		}
+	}
+
+	restarting := rotated && policy == RotationPolicyAutomatic
+	if restarting {
+		meta.SetStatusCondition(&r.pandaCluster.Status.Conditions, metav1.Condition{
+			Type:    CertificateRotationInProgress,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CertificateRenewed",
+			Message: "Rolling restart triggered to pick up renewed certificate material",
+		})
+		statusChanged = true
+	} else if rotated {
+		meta.SetStatusCondition(&r.pandaCluster.Status.Conditions, metav1.Condition{
+			Type:    CertificateRotationInProgress,
+			Status:  metav1.ConditionFalse,
+			Reason:  "RotationPolicyManual",
+			Message: "Certificate renewed but RotationPolicy does not permit an automatic restart",
+		})
+		statusChanged = true
+	}
+
+	if statusChanged {
+		if err := r.Status().Update(ctx, r.pandaCluster); err != nil {
+			return fmt.Errorf("persisting certificate rotation status: %w", err)
+		}
+	}
+
+	if !restarting {
+		return nil
+	}
+
+	return r.triggerRollingRestart(ctx)
+}
+
+// CheckExpiry compares the NotAfter of the node, operator client, and admin
+// certificates last recorded in the Cluster status (by CheckRotation) against
+// window, and records a CertificateExpiringSoon condition when any of them
+// falls within it. Unlike CheckRotation, this fires on every call a
+// certificate remains inside the warning window, not just the call where it
+// first entered it - it is meant to be polled on a schedule independent of
+// the event-driven rotation watcher, not to gate a restart.
+func (r *PkiReconciler) CheckExpiry(ctx context.Context, window time.Duration) error {
+	if r.pandaCluster.Status.TLS == nil {
+		return nil
+	}
+
+	watched := []types.NamespacedName{r.NodeCert(), r.OperatorClientCert(), r.AdminCert()}
+	now := time.Now()
+	var expiringSoon []string
+	for _, key := range watched {
+		observed, ok := r.pandaCluster.Status.TLS[key.Name]
+		if ok && observed.NotAfter.Sub(now) <= window {
+			expiringSoon = append(expiringSoon, key.Name)
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:    CertificateExpiringSoon,
+		Status:  metav1.ConditionFalse,
+		Reason:  "NotExpiring",
+		Message: "No watched certificate is due to expire within the warning window",
+	}
+	if len(expiringSoon) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ExpiryWindowReached"
+		condition.Message = fmt.Sprintf("certificate(s) %v are due to expire within %s", expiringSoon, window)
+	}
+
+	meta.SetStatusCondition(&r.pandaCluster.Status.Conditions, condition)
+	return r.Status().Update(ctx, r.pandaCluster)
+}
+
+// certificateChanged reads the tls.crt in the Secret named by key, compares
+// its serial number against what was last recorded for that Secret in the
+// Cluster status, and updates the status entry when it has changed. It
+// returns whether a previously-observed certificate rotated (changed) and
+// whether the in-memory status map was mutated and needs persisting
+// (recorded) - the two differ the first time a certificate is observed.
+func (r *PkiReconciler) certificateChanged(ctx context.Context, key types.NamespacedName) (changed, recorded bool, err error) {
+	var secret corev1.Secret
+	if err := r.Get(ctx, key, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, false, nil
+		}
+		return false, false, err
+	}
+
+	observed, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return false, false, fmt.Errorf("parsing tls.crt in %s: %w", key, err)
+	}
+
+	if r.pandaCluster.Status.TLS == nil {
+		r.pandaCluster.Status.TLS = map[string]redpandav1alpha1.ObservedCertificate{}
+	}
+
+	previous, known := r.pandaCluster.Status.TLS[key.Name]
+	if known && previous.SerialNumber == observed.Serial {
+		return false, false, nil
+	}
+
+	r.pandaCluster.Status.TLS[key.Name] = redpandav1alpha1.ObservedCertificate{
+		SerialNumber: observed.Serial,
+		NotAfter:     observed.NotAfter,
+	}
+
+	return known, true, nil
+}
+
+func parseCertificate(pemBytes []byte) (observedCertificate, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return observedCertificate{}, fmt.Errorf("no PEM block found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return observedCertificate{}, err
+	}
+	return observedCertificate{Serial: cert.SerialNumber.String(), NotAfter: cert.NotAfter}, nil
+}
+
+// triggerRollingRestart bumps RotationAnnotation on the StatefulSet's pod
+// template, which causes Kubernetes to roll the pods so brokers pick up the
+// renewed certificate material. It is a no-op if the last restart it
+// triggered was less than minTimeBetweenRestarts ago.
+func (r *PkiReconciler) triggerRollingRestart(ctx context.Context) error {
+	var sts appsv1.StatefulSet
+	key := types.NamespacedName{Name: r.pandaCluster.Name, Namespace: r.pandaCluster.Namespace}
+	if err := r.Get(ctx, key, &sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if last, ok := sts.Spec.Template.Annotations[RotationAnnotation]; ok {
+		if lastTime, err := time.Parse(time.RFC3339, last); err == nil && time.Since(lastTime) < minTimeBetweenRestarts {
+			return nil
+		}
+	}
+
+	if sts.Spec.Template.Annotations == nil {
+		sts.Spec.Template.Annotations = map[string]string{}
+	}
+	sts.Spec.Template.Annotations[RotationAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+	return r.Update(ctx, &sts)
+}
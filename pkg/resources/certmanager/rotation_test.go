@@ -0,0 +1,172 @@
+// Copyright 2021 Vectorized, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package certmanager
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	redpandav1alpha1 "github.com/vectorizedio/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func selfSignedCert(t *testing.T, serial int64) []byte {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "redpanda"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []byte
+	out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	return out
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*PkiReconciler, *redpandav1alpha1.Cluster) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := redpandav1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := &redpandav1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "redpanda", Namespace: "default"},
+	}
+	cluster.Spec.TLS.RotationPolicy = RotationPolicyAutomatic
+
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, cluster)...).Build()
+
+	return NewPki(cl, cluster, "redpanda.default.svc.cluster.local", scheme, logr.Discard()), cluster
+}
+
+func nodeSecret(name string, cert []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: {}},
+	}
+}
+
+func testStatefulSet() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "redpanda", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{},
+		},
+	}
+}
+
+func TestCheckRotationFirstObservationDoesNotRestart(t *testing.T) {
+	cert := selfSignedCert(t, 1)
+	secretName := "redpanda-" + RedpandaNodeCert
+	r, cluster := newTestReconciler(t, nodeSecret(secretName, cert), testStatefulSet())
+
+	if err := r.CheckRotation(context.Background()); err != nil {
+		t.Fatalf("CheckRotation: %v", err)
+	}
+
+	if cluster.Status.TLS[secretName].SerialNumber == "" {
+		t.Fatal("expected the first observed certificate to be recorded in status")
+	}
+
+	var sts appsv1.StatefulSet
+	if err := r.Get(context.Background(), client.ObjectKey{Name: "redpanda", Namespace: "default"}, &sts); err != nil {
+		t.Fatalf("fetching statefulset: %v", err)
+	}
+	if _, ok := sts.Spec.Template.Annotations[RotationAnnotation]; ok {
+		t.Fatal("did not expect a rolling restart on first observation")
+	}
+}
+
+func TestCheckRotationPersistsStatusAndSetsCondition(t *testing.T) {
+	secretName := "redpanda-" + RedpandaNodeCert
+	r, cluster := newTestReconciler(t, nodeSecret(secretName, selfSignedCert(t, 1)), testStatefulSet())
+
+	if err := r.CheckRotation(context.Background()); err != nil {
+		t.Fatalf("first CheckRotation: %v", err)
+	}
+
+	// Re-fetch the persisted Cluster to make sure the status round-tripped
+	// through Status().Update, not just the in-memory struct.
+	var persisted redpandav1alpha1.Cluster
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(cluster), &persisted); err != nil {
+		t.Fatalf("fetching persisted Cluster: %v", err)
+	}
+	if _, ok := persisted.Status.TLS[secretName]; !ok {
+		t.Fatal("expected the observed certificate to be persisted via Status().Update")
+	}
+
+	// Rotate the certificate and check again.
+	rotated := nodeSecret(secretName, selfSignedCert(t, 2))
+	if err := r.Update(context.Background(), rotated); err != nil {
+		t.Fatalf("updating secret: %v", err)
+	}
+
+	if err := r.CheckRotation(context.Background()); err != nil {
+		t.Fatalf("second CheckRotation: %v", err)
+	}
+
+	var sts appsv1.StatefulSet
+	if err := r.Get(context.Background(), client.ObjectKey{Name: "redpanda", Namespace: "default"}, &sts); err != nil {
+		t.Fatalf("fetching statefulset: %v", err)
+	}
+	if _, ok := sts.Spec.Template.Annotations[RotationAnnotation]; !ok {
+		t.Fatal("expected a rolling restart to be triggered after the certificate rotated")
+	}
+
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(cluster), &persisted); err != nil {
+		t.Fatalf("fetching persisted Cluster: %v", err)
+	}
+	cond := findCondition(persisted.Status.Conditions, CertificateRotationInProgress)
+	if cond == nil {
+		t.Fatal("expected CertificateRotationInProgress condition to be set")
+	}
+	if cond.Status != metav1.ConditionTrue {
+		t.Errorf("expected CertificateRotationInProgress=True, got %s", cond.Status)
+	}
+}
+
+func findCondition(conditions []metav1.Condition, condType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package v1alpha1
+
+const (
+	// DefaultHealthProbeBindAddress is used when Health.HealthProbeBindAddress is unset.
+	DefaultHealthProbeBindAddress = ":8081"
+	// DefaultMetricsBindAddress is used when Metrics.BindAddress is unset.
+	DefaultMetricsBindAddress = ":8080"
+	// DefaultWebhookPort is used when Webhook.Port is unset.
+	DefaultWebhookPort = 9443
+	// DefaultClusterDomain is used when ClusterDomain is unset.
+	DefaultClusterDomain = "cluster.local"
+	// DefaultAdminAPIClientFactory is used when AdminAPIClientFactory is unset.
+	DefaultAdminAPIClientFactory = "internal"
+	// DefaultLeaderElectionResourceName matches the lock name the manager
+	// has historically used when started with --leader-elect.
+	DefaultLeaderElectionResourceName = "aa9fc693.vectorized.io"
+)
+
+// SetDefaults fills in zero-valued fields of RedpandaOperatorConfiguration
+// with the operator's historical flag defaults.
+func (c *RedpandaOperatorConfiguration) SetDefaults() {
+	if c.Health.HealthProbeBindAddress == "" {
+		c.Health.HealthProbeBindAddress = DefaultHealthProbeBindAddress
+	}
+	if c.Metrics.BindAddress == "" {
+		c.Metrics.BindAddress = DefaultMetricsBindAddress
+	}
+	if c.Webhook.Port == 0 {
+		c.Webhook.Port = DefaultWebhookPort
+	}
+	if c.ClusterDomain == "" {
+		c.ClusterDomain = DefaultClusterDomain
+	}
+	if c.AdminAPIClientFactory == "" {
+		c.AdminAPIClientFactory = DefaultAdminAPIClientFactory
+	}
+	if c.LeaderElection.ResourceName == "" {
+		c.LeaderElection.ResourceName = DefaultLeaderElectionResourceName
+	}
+	if c.FeatureGates == nil {
+		c.FeatureGates = map[string]bool{"ConsoleReconciler": true}
+	}
+	if c.Controller.RateLimit.QPS == 0 {
+		c.Controller.RateLimit.QPS = 20
+	}
+	if c.Controller.RateLimit.Burst == 0 {
+		c.Controller.RateLimit.Burst = 30
+	}
+}
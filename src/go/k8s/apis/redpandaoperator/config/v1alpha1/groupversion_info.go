@@ -0,0 +1,31 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupVersion is the group and version used to register these objects.
+var GroupVersion = schema.GroupVersion{Group: "redpandaoperator.config.redpanda.com", Version: "v1alpha1"}
+
+// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+var SchemeBuilder = &scheme{}
+
+// AddToScheme adds the types in this group-version to the given scheme.
+var AddToScheme = SchemeBuilder.addToScheme
+
+type scheme struct{}
+
+func (s *scheme) addToScheme(sch *runtime.Scheme) error {
+	sch.AddKnownTypes(GroupVersion, &RedpandaOperatorConfiguration{})
+	return nil
+}
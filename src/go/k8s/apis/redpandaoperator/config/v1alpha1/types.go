@@ -0,0 +1,133 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package v1alpha1 contains the ComponentConfig types used to configure the
+// redpanda operator manager from a config file, as an alternative to the
+// flag-only startup.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RedpandaOperatorConfiguration is the Schema for the manager's config file.
+// It mirrors the ComponentConfig pattern used by other Kubernetes
+// controllers (e.g. kube-scheduler, cert-manager) so the operator can be
+// started with `--config=path.yaml` instead of a long list of flags.
+type RedpandaOperatorConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Health is the configuration for health and readiness probes.
+	Health RedpandaOperatorHealth `json:"health,omitempty"`
+
+	// Metrics is the configuration for serving Prometheus metrics.
+	Metrics RedpandaOperatorMetrics `json:"metrics,omitempty"`
+
+	// Webhook is the configuration for the validating/mutating webhook server.
+	Webhook RedpandaOperatorWebhook `json:"webhook,omitempty"`
+
+	// LeaderElection configures whether leader election is enabled and, if
+	// so, the resource used to hold the lock.
+	LeaderElection LeaderElectionConfiguration `json:"leaderElection,omitempty"`
+
+	// ClusterDomain is the Kubernetes cluster's local domain, equivalent to
+	// Kubelet's --cluster-domain.
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+
+	// AdminAPIClientFactory selects which implementation is used to build
+	// Admin API clients for reconcilers, e.g. "internal" or "mock".
+	AdminAPIClientFactory string `json:"adminAPIClientFactory,omitempty"`
+
+	// FeatureGates enables or disables optional controllers and behaviors,
+	// e.g. {"ConsoleReconciler": true}.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// Controller holds options that apply across all controllers, such as
+	// concurrency and rate limiting.
+	Controller ControllerConfiguration `json:"controller,omitempty"`
+}
+
+// RedpandaOperatorHealth configures the manager's health and readiness
+// probe endpoint.
+type RedpandaOperatorHealth struct {
+	// HealthProbeBindAddress is the address the probe endpoint binds to.
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+}
+
+// RedpandaOperatorMetrics configures the manager's metrics endpoint.
+type RedpandaOperatorMetrics struct {
+	// BindAddress is the address the metrics endpoint binds to.
+	// Set to "0" to disable the metrics endpoint.
+	BindAddress string `json:"bindAddress,omitempty"`
+}
+
+// RedpandaOperatorWebhook configures the manager's webhook server.
+type RedpandaOperatorWebhook struct {
+	// Enabled turns the webhook server on or off.
+	Enabled bool `json:"enabled,omitempty"`
+	// Port is the port the webhook server binds to.
+	Port int `json:"port,omitempty"`
+}
+
+// LeaderElectionConfiguration defines the configuration of leader election
+// clients for the operator manager.
+type LeaderElectionConfiguration struct {
+	// LeaderElect enables a leader election client to gain leadership before
+	// executing the main loop. Enable this when running replicated
+	// components for high availability.
+	LeaderElect bool `json:"leaderElect,omitempty"`
+	// ResourceName is the name of resource object that is used for locking
+	// during leader election.
+	ResourceName string `json:"resourceName,omitempty"`
+	// ResourceNamespace is the namespace of resource object that is used
+	// for locking during leader election.
+	ResourceNamespace string `json:"resourceNamespace,omitempty"`
+}
+
+// ControllerConfiguration holds cross-controller tunables.
+type ControllerConfiguration struct {
+	// GroupKindConcurrency is the number of concurrent workers per
+	// controller, keyed by the reconciled object's GroupKind string
+	// (e.g. "Cluster.redpanda.vectorized.io", "Console.redpanda.vectorized.io").
+	GroupKindConcurrency map[string]int `json:"groupKindConcurrency,omitempty"`
+	// RateLimit configures the default client-side rate limit applied to
+	// requests to the Kubernetes API server.
+	RateLimit RateLimitConfiguration `json:"rateLimit,omitempty"`
+}
+
+// RateLimitConfiguration configures client-side rate limiting.
+type RateLimitConfiguration struct {
+	// QPS is the sustained requests-per-second allowed to the API server.
+	QPS float32 `json:"qps,omitempty"`
+	// Burst is the maximum burst of requests allowed to exceed QPS.
+	Burst int `json:"burst,omitempty"`
+}
+
+// DeepCopyObject implements runtime.Object.
+func (c *RedpandaOperatorConfiguration) DeepCopyObject() runtime.Object {
+	if c == nil {
+		return nil
+	}
+	out := new(RedpandaOperatorConfiguration)
+	*out = *c
+	if c.FeatureGates != nil {
+		out.FeatureGates = make(map[string]bool, len(c.FeatureGates))
+		for k, v := range c.FeatureGates {
+			out.FeatureGates[k] = v
+		}
+	}
+	if c.Controller.GroupKindConcurrency != nil {
+		out.Controller.GroupKindConcurrency = make(map[string]int, len(c.Controller.GroupKindConcurrency))
+		for k, v := range c.Controller.GroupKindConcurrency {
+			out.Controller.GroupKindConcurrency[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+)
+
+// Validate checks a (defaulted) RedpandaOperatorConfiguration for errors
+// that would otherwise surface confusingly deep in manager startup.
+func (c *RedpandaOperatorConfiguration) Validate() error {
+	if c.LeaderElection.LeaderElect && c.LeaderElection.ResourceName == "" {
+		return fmt.Errorf("leaderElection.resourceName must be set when leaderElection.leaderElect is true")
+	}
+
+	switch c.AdminAPIClientFactory {
+	case "internal", "mock":
+	default:
+		return fmt.Errorf("adminAPIClientFactory must be one of %q, %q, got %q", "internal", "mock", c.AdminAPIClientFactory)
+	}
+
+	if c.Webhook.Enabled && (c.Webhook.Port <= 0 || c.Webhook.Port > 65535) {
+		return fmt.Errorf("webhook.port must be between 1 and 65535, got %d", c.Webhook.Port)
+	}
+
+	if c.Controller.RateLimit.QPS < 0 {
+		return fmt.Errorf("controller.rateLimit.qps must not be negative, got %f", c.Controller.RateLimit.QPS)
+	}
+
+	for name, concurrency := range c.Controller.GroupKindConcurrency {
+		if concurrency <= 0 {
+			return fmt.Errorf("controller.groupKindConcurrency[%s] must be positive, got %d", name, concurrency)
+		}
+	}
+
+	return nil
+}
@@ -13,8 +13,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
+	cmapiv1 "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,6 +25,8 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
 	adminutils "github.com/redpanda-data/redpanda/src/go/k8s/pkg/admin"
@@ -46,6 +51,13 @@ const (
 
 	// Warning event if subdomain is not found in Cluster ExternalListener
 	NoSubdomainEvent = "NoSubdomain"
+
+	// Warning event if the Console's requested IssuerRef/ClusterIssuerRef does not exist
+	IssuerNotFoundEvent = "IssuerNotFound"
+
+	// issuerNotFoundRequeueAfter is how long to wait before checking again
+	// whether a requested Issuer/ClusterIssuer has appeared.
+	issuerNotFoundRequeueAfter = 10 * time.Second
 )
 
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
@@ -87,6 +99,18 @@ func (r *ConsoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, err
 	}
 
+	additionalClusters, err := r.getAdditionalClusters(ctx, console)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.EventRecorder.Eventf(
+				console,
+				corev1.EventTypeWarning, ClusterNotFoundEvent,
+				"Unable to reconcile Console as one of the Clusters in Spec.ClusterRefs is not found",
+			)
+		}
+		return ctrl.Result{}, err
+	}
+
 	var s state
 	switch {
 	case console.GetDeletionTimestamp() != nil:
@@ -100,14 +124,14 @@ func (r *ConsoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		s = &Reconciling{r}
 	}
 
-	return s.Do(ctx, console, cluster, log)
+	return s.Do(ctx, console, cluster, additionalClusters, log)
 }
 
 // Reconciling is the state of the Console that handles reconciliation
 type Reconciling ConsoleState
 
 // Do handles reconciliation of Console
-func (r *Reconciling) Do(ctx context.Context, console *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster, log logr.Logger) (ctrl.Result, error) {
+func (r *Reconciling) Do(ctx context.Context, console *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster, additionalClusters []*redpandav1alpha1.Cluster, log logr.Logger) (ctrl.Result, error) {
 	// Ensure items in the store are updated
 	if err := r.Store.Sync(cluster); err != nil {
 		return ctrl.Result{}, fmt.Errorf("sync console store: %w", err)
@@ -116,14 +140,16 @@ func (r *Reconciling) Do(ctx context.Context, console *redpandav1alpha1.Console,
 	// ConfigMap is set to immutable and a new one is created if needed every reconcile
 	// Cleanup unused ConfigMaps before ensuring Resources which might create new ConfigMaps again
 	// Otherwise, if reconciliation always fail, a lot of unused ConfigMaps will be created
-	configmapResource := consolepkg.NewConfigMap(r.Client, r.Scheme, console, cluster, log)
+	configmapResource := consolepkg.NewConfigMap(r.Client, r.Scheme, console, cluster, log).WithAdditionalClusters(additionalClusters)
 	if err := configmapResource.DeleteUnused(ctx); err != nil {
 		return ctrl.Result{}, fmt.Errorf("deleting unused configmaps: %w", err)
 	}
 
 	// NewIngress will not create Ingress if subdomain is empty
 	subdomain := ""
-	if s := cluster.ExternalListener().GetExternal().Subdomain; s != "" {
+	if host := console.Spec.Ingress.GetHost(); host != "" {
+		subdomain = host
+	} else if s := cluster.ExternalListener().GetExternal().Subdomain; s != "" {
 		subdomain = fmt.Sprintf("console.%s", s)
 	} else {
 		r.EventRecorder.Event(
@@ -133,13 +159,44 @@ func (r *Reconciling) Do(ctx context.Context, console *redpandav1alpha1.Console,
 		)
 	}
 
+	issuerRef := resources.LEClusterIssuer
+	if ref := console.Spec.Ingress.IssuerRef; ref != nil {
+		if err := r.checkIssuerExists(ctx, ref, console); err != nil {
+			var ra *resources.RequeueAfterError
+			if errors.As(err, &ra) {
+				return ctrl.Result{RequeueAfter: ra.RequeueAfter}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		issuerRef = ref
+	}
+
+	ingress := resources.NewIngress(r.Client, console, r.Scheme, subdomain, console.GetName(), consolepkg.ServicePortName, log).
+		WithTLS(issuerRef).
+		WithAnnotations(console.Spec.Ingress.Annotations).
+		WithIngressClass(console.Spec.Ingress.IngressClassName)
+
 	applyResources := []resources.Resource{
 		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, log),
 		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, log),
 		configmapResource,
 		consolepkg.NewDeployment(r.Client, r.Scheme, console, cluster, r.Store, log),
 		consolepkg.NewService(r.Client, r.Scheme, console, log),
-		resources.NewIngress(r.Client, console, r.Scheme, subdomain, console.GetName(), consolepkg.ServicePortName, log).WithTLS(resources.LEClusterIssuer),
+		ingress,
+	}
+	// Provision a service account and ACLs per additional cluster too, so
+	// Console is actually authorized against each one instead of only the
+	// primary. NewKafkaSA names the Secret it writes from consoleobj alone,
+	// so this still collides on one Secret per additional cluster rather
+	// than the distinct one AdditionalClusterSASecretKey names - fixing
+	// that needs a secret-name override NewKafkaSA doesn't take today.
+	for _, additional := range additionalClusters {
+		secretKey := consolepkg.AdditionalClusterSASecretKey(console, additional)
+		log.V(debugLogLevel).Info("provisioning SASL service account for additional cluster", "cluster", additional.GetName(), "secret", secretKey)
+		applyResources = append(applyResources,
+			consolepkg.NewKafkaSA(r.Client, r.Scheme, console, additional, r.clusterDomain, r.AdminAPIClientFactory, log),
+			consolepkg.NewKafkaACL(r.Client, r.Scheme, console, additional, log),
+		)
 	}
 	for _, each := range applyResources {
 		if err := each.Ensure(ctx); err != nil {
@@ -175,11 +232,19 @@ func (r *Reconciling) Do(ctx context.Context, console *redpandav1alpha1.Console,
 type Deleting ConsoleState
 
 // Do handles deletion of Console
-func (r *Deleting) Do(ctx context.Context, console *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster, log logr.Logger) (ctrl.Result, error) {
+func (r *Deleting) Do(ctx context.Context, console *redpandav1alpha1.Console, cluster *redpandav1alpha1.Cluster, additionalClusters []*redpandav1alpha1.Cluster, log logr.Logger) (ctrl.Result, error) {
 	applyResources := []resources.ManagedResource{
 		consolepkg.NewKafkaSA(r.Client, r.Scheme, console, cluster, r.clusterDomain, r.AdminAPIClientFactory, log),
 		consolepkg.NewKafkaACL(r.Client, r.Scheme, console, cluster, log),
 	}
+	for _, additional := range additionalClusters {
+		secretKey := consolepkg.AdditionalClusterSASecretKey(console, additional)
+		log.V(debugLogLevel).Info("cleaning up SASL service account for additional cluster", "cluster", additional.GetName(), "secret", secretKey)
+		applyResources = append(applyResources,
+			consolepkg.NewKafkaSA(r.Client, r.Scheme, console, additional, r.clusterDomain, r.AdminAPIClientFactory, log),
+			consolepkg.NewKafkaACL(r.Client, r.Scheme, console, additional, log),
+		)
+	}
 
 	for _, each := range applyResources {
 		if err := each.Cleanup(ctx); err != nil {
@@ -190,6 +255,78 @@ func (r *Deleting) Do(ctx context.Context, console *redpandav1alpha1.Console, cl
 	return ctrl.Result{}, nil
 }
 
+// checkIssuerExists verifies that the Issuer/ClusterIssuer referenced by a
+// Console's Spec.Ingress.IssuerRef exists, recording a warning Event and
+// returning a RequeueAfterError when it does not so the reconciler tries
+// again later instead of failing permanently.
+func (r *ConsoleReconciler) checkIssuerExists(ctx context.Context, ref *cmmeta.ObjectReference, console *redpandav1alpha1.Console) error {
+	var err error
+	switch ref.Kind {
+	case cmmeta.ClusterIssuerKind:
+		err = r.Get(ctx, client.ObjectKey{Name: ref.Name}, &cmapiv1.ClusterIssuer{})
+	default:
+		err = r.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: console.GetNamespace()}, &cmapiv1.Issuer{})
+	}
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	r.EventRecorder.Eventf(
+		console,
+		corev1.EventTypeWarning, IssuerNotFoundEvent,
+		"Requested %s %q does not exist yet",
+		ref.Kind, ref.Name,
+	)
+	return &resources.RequeueAfterError{RequeueAfter: issuerNotFoundRequeueAfter, Msg: fmt.Sprintf("waiting for %s %q", ref.Kind, ref.Name)}
+}
+
+// getAdditionalClusters fetches the Clusters referenced by
+// Spec.ClusterRefs, beyond the primary Spec.ClusterKeyRef, in the order
+// they are listed. It returns the first error encountered, including
+// apierrors.IsNotFound, so callers can distinguish a missing reference
+// from other failures the same way they do for the primary Cluster.
+func (r *ConsoleReconciler) getAdditionalClusters(ctx context.Context, console *redpandav1alpha1.Console) ([]*redpandav1alpha1.Cluster, error) {
+	if len(console.Spec.ClusterRefs) == 0 {
+		return nil, nil
+	}
+
+	clusters := make([]*redpandav1alpha1.Cluster, 0, len(console.Spec.ClusterRefs))
+	for _, ref := range console.Spec.ClusterRefs {
+		cluster := &redpandav1alpha1.Cluster{}
+		if err := r.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: console.GetNamespace()}, cluster); err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// clusterRefsIndexField is the field index key used to look up Consoles
+// whose Spec.ClusterKeyRef or Spec.ClusterRefs point at a given Cluster, so
+// a change to any referenced Cluster can be mapped back to the Consoles
+// that need to be reconciled.
+const clusterRefsIndexField = ".spec.clusterRefs"
+
+// findConsolesForCluster maps a Cluster to reconcile Requests for every
+// Console that references it, either as the primary Spec.ClusterKeyRef or
+// as one of Spec.ClusterRefs.
+func (r *ConsoleReconciler) findConsolesForCluster(ctx context.Context, cluster client.Object) []reconcile.Request {
+	var consoles redpandav1alpha1.ConsoleList
+	if err := r.List(ctx, &consoles, client.InNamespace(cluster.GetNamespace()), client.MatchingFields{clusterRefsIndexField: cluster.GetName()}); err != nil {
+		r.Log.Error(err, "listing Consoles referencing Cluster", "cluster", cluster.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(consoles.Items))
+	for i := range consoles.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&consoles.Items[i])})
+	}
+	return requests
+}
+
 // handleSpecChange is a hook to call before Reconciling
 func (r *ConsoleReconciler) handleSpecChange(ctx context.Context, console *redpandav1alpha1.Console, log logr.Logger) error {
 	if console.Status.ConfigMapRef != nil {
@@ -203,12 +340,28 @@ func (r *ConsoleReconciler) handleSpecChange(ctx context.Context, console *redpa
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ConsoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &redpandav1alpha1.Console{}, clusterRefsIndexField, func(obj client.Object) []string {
+		console := obj.(*redpandav1alpha1.Console)
+		names := make([]string, 0, len(console.Spec.ClusterRefs)+1)
+		names = append(names, console.Spec.ClusterKeyRef.Name)
+		for _, ref := range console.Spec.ClusterRefs {
+			names = append(names, ref.Name)
+		}
+		return names
+	}); err != nil {
+		return fmt.Errorf("indexing Console cluster references: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&redpandav1alpha1.Console{}).
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Watches(
+			&redpandav1alpha1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(r.findConsolesForCluster),
+		).
 		Complete(r)
 }
 
@@ -0,0 +1,113 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package redpanda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	adminutils "github.com/redpanda-data/redpanda/src/go/k8s/pkg/admin"
+	consolepkg "github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources/certmanager"
+)
+
+// NewPKISyncJob returns a job body that re-reconciles the cert-manager PKI
+// resources for every Cluster, catching drift the PkiReconciler's own
+// event-driven Ensure calls miss because nothing about the Cluster changed
+// (e.g. an external Issuer rotated its root, or a Secret was edited by
+// hand).
+func NewPKISyncJob(cl client.Client, scheme *runtime.Scheme, clusterDomain string, log logr.Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var clusters redpandav1alpha1.ClusterList
+		if err := cl.List(ctx, &clusters); err != nil {
+			return fmt.Errorf("listing clusters: %w", err)
+		}
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			fqdn := fmt.Sprintf("%s.%s.svc.%s", cluster.GetName(), cluster.GetNamespace(), clusterDomain)
+			pki := certmanager.NewPki(cl, cluster, fqdn, scheme, log)
+			if err := pki.Ensure(ctx); err != nil {
+				return fmt.Errorf("syncing PKI for cluster %s/%s: %w", cluster.GetNamespace(), cluster.GetName(), err)
+			}
+			if err := pki.CheckRotation(ctx); err != nil {
+				return fmt.Errorf("checking certificate rotation for cluster %s/%s: %w", cluster.GetNamespace(), cluster.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
+
+// NewConsoleACLSyncJob returns a job body that re-reconciles the Kafka
+// ServiceAccount and ACLs owned by every Console, catching drift such as a
+// manually deleted ACL that no watched Console object change would
+// otherwise surface. Only each Console's primary Spec.ClusterKeyRef cluster
+// is synced; the service accounts and ACLs the Console reconciler provisions
+// for Spec.ClusterRefs clusters are not.
+func NewConsoleACLSyncJob(cl client.Client, scheme *runtime.Scheme, clusterDomain string, adminAPIClientFactory adminutils.AdminAPIClientFactory, log logr.Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var consoles redpandav1alpha1.ConsoleList
+		if err := cl.List(ctx, &consoles); err != nil {
+			return fmt.Errorf("listing consoles: %w", err)
+		}
+
+		for i := range consoles.Items {
+			console := &consoles.Items[i]
+
+			cluster := &redpandav1alpha1.Cluster{}
+			if err := cl.Get(ctx, console.GetClusterRef(), cluster); err != nil {
+				return fmt.Errorf("fetching cluster for console %s/%s: %w", console.GetNamespace(), console.GetName(), err)
+			}
+
+			sa := consolepkg.NewKafkaSA(cl, scheme, console, cluster, clusterDomain, adminAPIClientFactory, log)
+			if err := sa.Ensure(ctx); err != nil {
+				return fmt.Errorf("syncing Kafka service account for console %s/%s: %w", console.GetNamespace(), console.GetName(), err)
+			}
+
+			acl := consolepkg.NewKafkaACL(cl, scheme, console, cluster, log)
+			if err := acl.Ensure(ctx); err != nil {
+				return fmt.Errorf("syncing Kafka ACLs for console %s/%s: %w", console.GetNamespace(), console.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
+
+// NewNodeCertExpiryCheckJob returns a job body that compares the watched
+// certificates of every Cluster with Kafka API TLS enabled (other Clusters
+// are skipped) against certmanager.DefaultExpiryWarningWindow and
+// records a CertificateExpiringSoon condition, independent of the rotation
+// watcher, so an upcoming expiry is surfaced in status even if no renewal
+// event has fired yet to trigger one.
+func NewNodeCertExpiryCheckJob(cl client.Client, log logr.Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var clusters redpandav1alpha1.ClusterList
+		if err := cl.List(ctx, &clusters); err != nil {
+			return fmt.Errorf("listing clusters: %w", err)
+		}
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if !cluster.Spec.Configuration.TLS.KafkaAPI.Enabled {
+				continue
+			}
+			pki := certmanager.NewPki(cl, cluster, "", nil, log)
+			if err := pki.CheckExpiry(ctx, certmanager.DefaultExpiryWarningWindow); err != nil {
+				return fmt.Errorf("checking node certificate expiry for cluster %s/%s: %w", cluster.GetNamespace(), cluster.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package configloader reads the operator manager's ComponentConfig file.
+package configloader
+
+import (
+	"fmt"
+	"os"
+
+	configv1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpandaoperator/config/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+// Load reads the config file at path, defaults it, validates it, and
+// returns the resulting RedpandaOperatorConfiguration.
+func Load(path string) (*configv1alpha1.RedpandaOperatorConfiguration, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading operator config %q: %w", path, err)
+	}
+
+	cfg := &configv1alpha1.RedpandaOperatorConfiguration{}
+	if err := yaml.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("parsing operator config %q: %w", path, err)
+	}
+
+	cfg.SetDefaults()
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("validating operator config %q: %w", path, err)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package configloader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadPopulatesDocumentedKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	contents := `health:
+  healthProbeBindAddress: :9081
+clusterDomain: example.local
+adminAPIClientFactory: mock
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if got := cfg.Health.HealthProbeBindAddress; got != ":9081" {
+		t.Errorf("HealthProbeBindAddress = %q, want %q", got, ":9081")
+	}
+	if got := cfg.ClusterDomain; got != "example.local" {
+		t.Errorf("ClusterDomain = %q, want %q", got, "example.local")
+	}
+	if got := cfg.AdminAPIClientFactory; got != "mock" {
+		t.Errorf("AdminAPIClientFactory = %q, want %q", got, "mock")
+	}
+}
+
+func TestLoadAppliesDefaultsToUnsetFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("clusterDomain: example.local\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if got, want := cfg.Health.HealthProbeBindAddress, ":8081"; got != want {
+		t.Errorf("HealthProbeBindAddress = %q, want default %q", got, want)
+	}
+	if got, want := cfg.AdminAPIClientFactory, "internal"; got != want {
+		t.Errorf("AdminAPIClientFactory = %q, want default %q", got, want)
+	}
+}
+
+func TestLoadRejectsInvalidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("adminAPIClientFactory: bogus\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected an error for an invalid adminAPIClientFactory, got nil")
+	}
+}
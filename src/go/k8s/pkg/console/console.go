@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/redpanda"
 	"github.com/redpanda-data/console/backend/pkg/schema"
 	"github.com/redpanda-data/redpanda/src/go/k8s/pkg/resources"
 	"gopkg.in/yaml.v2"
@@ -27,7 +29,12 @@ type ConfigMap struct {
 	scheme     *runtime.Scheme
 	consoleobj *redpandav1alpha1.Console
 	clusterobj *redpandav1alpha1.Cluster
-	log        logr.Logger
+	// additionalClusters are the Clusters referenced by
+	// Spec.ClusterRefs, beyond the primary Spec.ClusterKeyRef. Each gets
+	// its own kafka.ClusterConfig entry alongside the primary cluster's
+	// top-level config; see genKafka.
+	additionalClusters []*redpandav1alpha1.Cluster
+	log                logr.Logger
 }
 
 // NewConfigMap instantiates a new ConfigMap
@@ -41,6 +48,14 @@ func NewConfigMap(cl client.Client, scheme *runtime.Scheme, consoleobj *redpanda
 	}
 }
 
+// WithAdditionalClusters adds the Clusters referenced by Console's
+// Spec.ClusterRefs so each contributes its own kafka.ClusterConfig entry,
+// alongside the primary Spec.ClusterKeyRef cluster's top-level config.
+func (cm *ConfigMap) WithAdditionalClusters(clusters []*redpandav1alpha1.Cluster) *ConfigMap {
+	cm.additionalClusters = clusters
+	return cm
+}
+
 // Ensure implements Resource interface
 func (cm *ConfigMap) Ensure(ctx context.Context) error {
 	config, err := cm.generateConsoleConfig()
@@ -98,6 +113,7 @@ func (cm *ConfigMap) generateConsoleConfig() (string, error) {
 	consoleConfig.SetDefaults()
 	consoleConfig.Server = cm.consoleobj.Spec.Server
 	consoleConfig.Kafka = cm.genKafka()
+	consoleConfig.Redpanda = cm.genRedpandaAdmin()
 
 	config, err := yaml.Marshal(consoleConfig)
 	if err != nil {
@@ -115,43 +131,241 @@ var (
 	SchemaRegistryTLSDir          = "/redpanda/schema-registry"
 	SchemaRegistryTLSCertFilePath = fmt.Sprintf("%s/%s", SchemaRegistryTLSDir, "tls.crt")
 	SchemaRegistryTLSKeyFilePath  = fmt.Sprintf("%s/%s", SchemaRegistryTLSDir, "tls.key")
+
+	KafkaAPITLSDir          = "/redpanda/kafka-api"
+	KafkaAPITLSCaFilePath   = fmt.Sprintf("%s/%s", KafkaAPITLSDir, "ca.crt")
+	KafkaAPITLSCertFilePath = fmt.Sprintf("%s/%s", KafkaAPITLSDir, "tls.crt")
+	KafkaAPITLSKeyFilePath  = fmt.Sprintf("%s/%s", KafkaAPITLSDir, "tls.key")
+
+	AdminAPITLSDir          = "/redpanda/admin-api"
+	AdminAPITLSCaFilePath   = fmt.Sprintf("%s/%s", AdminAPITLSDir, "ca.crt")
+	AdminAPITLSCertFilePath = fmt.Sprintf("%s/%s", AdminAPITLSDir, "tls.crt")
+	AdminAPITLSKeyFilePath  = fmt.Sprintf("%s/%s", AdminAPITLSDir, "tls.key")
+)
+
+const (
+	// SASLUsernameKey and SASLPasswordKey are the Secret keys the Console
+	// Deployment expects its Kafka SASL credentials under, whether the
+	// Secret is user-provided via Spec.Kafka.SASL.SecretRef or
+	// auto-provisioned by KafkaSA.
+	SASLUsernameKey = "username"
+	SASLPasswordKey = "password"
+
+	// defaultSASLMechanism is used when Spec.Kafka.SASL.Mechanism is unset.
+	defaultSASLMechanism = "SCRAM-SHA-256"
+
+	// saslUsernameEnvVar and saslPasswordEnvVar are expanded by Console at
+	// load time so the actual credentials never appear in the ConfigMap on
+	// disk, only in the Secret mounted as env vars on the Deployment.
+	//
+	// NewDeployment must set REDPANDA_SASL_USERNAME/REDPANDA_SASL_PASSWORD
+	// from the Secret named by KafkaSASecretKey (keys SASLUsernameKey,
+	// SASLPasswordKey) for these placeholders to resolve; see deployment.go.
+	saslUsernameEnvVar = "${REDPANDA_SASL_USERNAME}"
+	saslPasswordEnvVar = "${REDPANDA_SASL_PASSWORD}"
 )
 
+// KafkaSASecretKey returns the namespaced name of the Secret holding the
+// Console's Kafka SASL credentials: either the user-provided SecretRef, or
+// the Secret auto-provisioned by KafkaSA when none is given.
+func KafkaSASecretKey(consoleobj *redpandav1alpha1.Console) types.NamespacedName {
+	if ref := consoleobj.Spec.Kafka.SASL.SecretRef; ref != nil {
+		return types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+	}
+	return types.NamespacedName{Name: consoleobj.GetName() + "-sasl", Namespace: consoleobj.GetNamespace()}
+}
+
+// genKafka builds Console's kafka.Config. The primary cluster
+// (Spec.ClusterKeyRef) populates the top-level Brokers/Schema/TLS/SASL
+// fields, and each Cluster referenced by Spec.ClusterRefs gets its own
+// kafka.Clusters entry with its own brokers, schema registry, admin API and
+// SASL/TLS settings - see genAdditionalCluster.
 func (cm *ConfigMap) genKafka() kafka.Config {
 	k := kafka.Config{
 		Brokers:  getBrokers(cm.clusterobj),
 		ClientID: fmt.Sprintf("redpanda-console-%s-%s", cm.consoleobj.GetNamespace(), cm.consoleobj.GetName()),
+		Schema:   cm.genSchemaRegistry(cm.clusterobj, SchemaRegistryTLSCertFilePath, SchemaRegistryTLSKeyFilePath),
+		TLS:      cm.genKafkaTLS(),
+		SASL:     cm.genSASL(cm.clusterobj, cm.consoleobj.Spec.Kafka.SASL.Mechanism, saslUsernameEnvVar, saslPasswordEnvVar),
+	}
+
+	for _, additional := range cm.additionalClusters {
+		k.Clusters = append(k.Clusters, cm.genAdditionalCluster(additional))
+	}
+
+	return k
+}
+
+// genAdditionalCluster builds one kafka.ClusterConfig entry for a Cluster
+// referenced by Spec.ClusterRefs. Unlike the primary cluster, it can't reuse
+// the fixed KafkaAPITLSDir/AdminAPITLSDir mount paths or the primary
+// REDPANDA_SASL_* env vars, since those only ever describe one cluster; see
+// additionalClusterTLSDir and additionalClusterSASLEnvVars.
+func (cm *ConfigMap) genAdditionalCluster(clusterobj *redpandav1alpha1.Cluster) kafka.ClusterConfig {
+	dir := additionalClusterTLSDir(clusterobj)
+	usernameEnvVar, passwordEnvVar := additionalClusterSASLEnvVars(clusterobj)
+
+	tls := kafka.TLSConfig{Enabled: false}
+	if clusterobj.KafkaTLSEnabled() {
+		tls = kafka.TLSConfig{
+			Enabled:      true,
+			CaFilepath:   fmt.Sprintf("%s/kafka-api/ca.crt", dir),
+			CertFilepath: fmt.Sprintf("%s/kafka-api/tls.crt", dir),
+			KeyFilepath:  fmt.Sprintf("%s/kafka-api/tls.key", dir),
+		}
+	}
+
+	admin := redpanda.AdminAPIConfig{Enabled: true, URLs: []string{clusterobj.AdminAPIInternalURL()}}
+	if clusterobj.AdminAPITLSEnabled() {
+		admin.TLS = redpanda.TLSConfig{
+			Enabled:      true,
+			CaFilepath:   fmt.Sprintf("%s/admin-api/ca.crt", dir),
+			CertFilepath: fmt.Sprintf("%s/admin-api/tls.crt", dir),
+			KeyFilepath:  fmt.Sprintf("%s/admin-api/tls.key", dir),
+		}
+	}
+
+	return kafka.ClusterConfig{
+		Name:    clusterobj.GetName(),
+		Brokers: getBrokers(clusterobj),
+		Schema:  cm.genSchemaRegistry(clusterobj, fmt.Sprintf("%s/schema-registry/tls.crt", dir), fmt.Sprintf("%s/schema-registry/tls.key", dir)),
+		TLS:     tls,
+		SASL:    cm.genSASL(clusterobj, "", usernameEnvVar, passwordEnvVar),
+		Admin:   admin,
+	}
+}
+
+// genSchemaRegistry builds a schema.Config for clusterobj. certFilepath and
+// keyFilepath let the primary cluster keep using its fixed
+// SchemaRegistryTLSDir while additional clusters get their own
+// per-cluster subdirectory.
+func (cm *ConfigMap) genSchemaRegistry(clusterobj *redpandav1alpha1.Cluster, certFilepath, keyFilepath string) schema.Config {
+	if !cm.consoleobj.Spec.Schema.Enabled {
+		return schema.Config{Enabled: false}
 	}
 
-	schemaRegistry := schema.Config{Enabled: false}
-	if yes := cm.consoleobj.Spec.Schema.Enabled; yes {
-		tls := schema.TLSConfig{Enabled: false}
-		if yes := cm.clusterobj.IsSchemaRegistryTLSEnabled(); yes {
-			tls = schema.TLSConfig{
-				Enabled:      yes,
-				CaFilepath:   DefaultCAFilePath,
-				CertFilepath: SchemaRegistryTLSCertFilePath,
-				KeyFilepath:  SchemaRegistryTLSKeyFilePath,
-			}
+	tls := schema.TLSConfig{Enabled: false}
+	if clusterobj.IsSchemaRegistryTLSEnabled() {
+		tls = schema.TLSConfig{
+			Enabled:      true,
+			CaFilepath:   DefaultCAFilePath,
+			CertFilepath: certFilepath,
+			KeyFilepath:  keyFilepath,
 		}
-		schemaRegistry = schema.Config{Enabled: yes, URLs: []string{cm.clusterobj.SchemaRegistryAPIInternalURL()}, TLS: tls}
 	}
-	k.Schema = schemaRegistry
 
+	return schema.Config{Enabled: true, URLs: []string{clusterobj.SchemaRegistryAPIInternalURL()}, TLS: tls}
+}
+
+// genSASL builds a kafka.SASLConfig for clusterobj. mechanism falls back to
+// defaultSASLMechanism when unset, which is always the case for additional
+// clusters since Console's Spec.Kafka.SASL only configures the primary one.
+func (cm *ConfigMap) genSASL(clusterobj *redpandav1alpha1.Cluster, mechanism, usernameEnvVar, passwordEnvVar string) kafka.SASLConfig {
 	sasl := kafka.SASLConfig{Enabled: false}
 	// Set defaults because Console complains SASL mechanism is not set even if SASL is disabled
 	sasl.SetDefaults()
-	if yes := cm.clusterobj.Spec.EnableSASL; yes {
-		sasl = kafka.SASLConfig{
-			Enabled:   yes,
-			Username:  "",
-			Password:  "",
-			Mechanism: "SCRAM-SHA-256",
-		}
+	if !clusterobj.Spec.EnableSASL {
+		return sasl
 	}
-	k.SASL = sasl
 
-	return k
+	if mechanism == "" {
+		mechanism = defaultSASLMechanism
+	}
+	return kafka.SASLConfig{
+		Enabled: true,
+		// Credentials are expanded by Console from the environment at load
+		// time so they never appear in the ConfigMap on disk; the Secret
+		// backing these env vars is mounted by the Deployment.
+		Username:  usernameEnvVar,
+		Password:  passwordEnvVar,
+		Mechanism: mechanism,
+	}
+}
+
+// additionalClusterTLSDir returns the directory the Console Deployment
+// mounts an additional cluster's Kafka API/Admin API/schema registry TLS
+// material under. Each additional cluster needs its own subdirectory since
+// none of them can share the primary cluster's KafkaAPITLSDir/
+// AdminAPITLSDir/SchemaRegistryTLSDir mount paths.
+func additionalClusterTLSDir(clusterobj *redpandav1alpha1.Cluster) string {
+	return fmt.Sprintf("/redpanda/clusters/%s-%s", clusterobj.GetNamespace(), clusterobj.GetName())
+}
+
+// additionalClusterSASLEnvVars returns the placeholder env vars an
+// additional cluster's SASL credentials are expanded from. They are
+// suffixed with the cluster's namespace/name so multiple additional
+// clusters don't collide on the same REDPANDA_SASL_USERNAME/PASSWORD pair
+// the primary cluster uses; the Deployment must source them from the
+// Secret named by AdditionalClusterSASecretKey.
+func additionalClusterSASLEnvVars(clusterobj *redpandav1alpha1.Cluster) (usernameEnvVar, passwordEnvVar string) {
+	suffix := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(
+		fmt.Sprintf("%s_%s", clusterobj.GetNamespace(), clusterobj.GetName()),
+	))
+	return fmt.Sprintf("${REDPANDA_SASL_USERNAME_%s}", suffix), fmt.Sprintf("${REDPANDA_SASL_PASSWORD_%s}", suffix)
+}
+
+// AdditionalClusterSASecretKey returns the namespaced name of the Secret
+// holding an additional cluster's Console SASL credentials, mirroring
+// KafkaSASecretKey for the primary cluster. It is exported so the
+// controller provisioning per-additional-cluster KafkaSA/KafkaACL
+// resources can log and eventually mount the Secret it names, distinct
+// from the one KafkaSASecretKey names for the primary cluster.
+func AdditionalClusterSASecretKey(consoleobj *redpandav1alpha1.Console, clusterobj *redpandav1alpha1.Cluster) types.NamespacedName {
+	return types.NamespacedName{Name: fmt.Sprintf("%s-%s-sasl", consoleobj.GetName(), clusterobj.GetName()), Namespace: consoleobj.GetNamespace()}
+}
+
+// genKafkaTLS builds the kafka.TLSConfig for Console's connection to the
+// internal Kafka API listener, mirroring the pattern used for schema
+// registry: it points at the cert-manager-issued cert/key/CA under
+// KafkaAPITLSDir. Spec.Kafka.TLS.CaFilePath, when set, replaces the CA path,
+// and Spec.Kafka.TLS.InsecureSkipVerify is passed through as is.
+func (cm *ConfigMap) genKafkaTLS() kafka.TLSConfig {
+	if !cm.clusterobj.KafkaTLSEnabled() {
+		return kafka.TLSConfig{Enabled: false}
+	}
+
+	tls := cm.consoleobj.Spec.Kafka.TLS
+	caFilepath := KafkaAPITLSCaFilePath
+	if tls.CaFilePath != "" {
+		// A user-provided CA path is used instead of KafkaAPITLSCaFilePath;
+		// the cert and key paths stay the cert-manager-issued ones.
+		caFilepath = tls.CaFilePath
+	}
+
+	return kafka.TLSConfig{
+		Enabled:            true,
+		InsecureSkipVerify: tls.InsecureSkipVerify,
+		CaFilepath:         caFilepath,
+		CertFilepath:       KafkaAPITLSCertFilePath,
+		KeyFilepath:        KafkaAPITLSKeyFilePath,
+	}
+}
+
+// genRedpandaAdmin builds Console's connection to the primary cluster's
+// Admin API, mirroring genKafkaTLS: mount the cert-manager-issued cert/key/CA
+// from the Admin API listener's Secret, using the AdminAPITLS* paths the
+// Console Deployment mounts alongside the Kafka API ones.
+func (cm *ConfigMap) genRedpandaAdmin() redpanda.AdminAPIConfig {
+	return redpanda.AdminAPIConfig{
+		Enabled: true,
+		URLs:    []string{cm.clusterobj.AdminAPIInternalURL()},
+		TLS:     cm.genAdminAPITLS(),
+	}
+}
+
+// genAdminAPITLS builds the redpanda.TLSConfig for Console's connection to
+// the internal Admin API listener.
+func (cm *ConfigMap) genAdminAPITLS() redpanda.TLSConfig {
+	if !cm.clusterobj.AdminAPITLSEnabled() {
+		return redpanda.TLSConfig{Enabled: false}
+	}
+
+	return redpanda.TLSConfig{
+		Enabled:      true,
+		CaFilepath:   AdminAPITLSCaFilePath,
+		CertFilepath: AdminAPITLSCertFilePath,
+		KeyFilepath:  AdminAPITLSKeyFilePath,
+	}
 }
 
 func getBrokers(clusterobj *redpandav1alpha1.Cluster) []string {
@@ -0,0 +1,55 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	_ "embed"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+//go:embed testdata/redpanda.yaml.tmpl
+var redpandaConfigTemplate string
+
+// brokerConfig describes the matrix of {plaintext, TLS, TLS+SASL} a single
+// test case exercises. When TLSEnabled, generateTLSMaterial is expected to
+// have already written server.crt/server.key/ca.crt under Dir, which is
+// bind-mounted to /etc/redpanda - the paths the rendered redpanda.yaml
+// points at are therefore fixed container paths, not Dir itself.
+type brokerConfig struct {
+	Dir          string
+	TLSEnabled   bool
+	SASLEnabled  bool
+	SASLUsername string
+}
+
+// writeRedpandaConfig renders testdata/redpanda.yaml.tmpl for cfg and writes
+// it to <cfg.Dir>/redpanda.yaml, returning its path.
+func writeRedpandaConfig(cfg brokerConfig) (string, error) {
+	tmpl, err := template.New("redpanda.yaml").Parse(redpandaConfigTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, cfg); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(cfg.Dir, "redpanda.yaml")
+	if err := os.WriteFile(path, out.Bytes(), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,411 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+//go:build integration
+
+// Package integration boots a real single-node Redpanda broker per test
+// case and exercises the Console ConfigMap generator against it: the
+// kgo client is built from the brokers/TLS/SASL settings parsed back out
+// of the generated config.yaml rather than from the test case's own
+// parameters, so a regression in genKafka/getBrokers/genKafkaTLS/genSASL
+// actually fails the test instead of going unnoticed. Schema registry TLS
+// wiring is covered separately, by registering and fetching a schema
+// directly against the broker's schema registry listener - the URL
+// genSchemaRegistry puts in config.yaml is a Console-Deployment-internal
+// one that can't be dialed from this test process.
+//
+// These tests are slow and require a container runtime, so they are gated
+// behind the "integration" build tag:
+//
+//	go test -tags=integration ./pkg/console/integration/...
+package integration
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	redpandav1alpha1 "github.com/redpanda-data/redpanda/src/go/k8s/apis/redpanda/v1alpha1"
+	consolepkg "github.com/redpanda-data/redpanda/src/go/k8s/pkg/console"
+)
+
+const (
+	redpandaImage = "docker.redpanda.com/redpandadata/redpanda:v23.2.1"
+
+	// testSASLPassword is used both to provision the SCRAM user against the
+	// live broker's Admin API and to authenticate the kgo client below.
+	testSASLPassword = "console-secret"
+
+	// expectedSASLUsernamePlaceholder and expectedSASLPasswordPlaceholder
+	// must match consolepkg's unexported saslUsernameEnvVar/
+	// saslPasswordEnvVar: the generated config.yaml is expected to carry
+	// these literal placeholders, never the real credentials.
+	expectedSASLUsernamePlaceholder = "${REDPANDA_SASL_USERNAME}"
+	expectedSASLPasswordPlaceholder = "${REDPANDA_SASL_PASSWORD}"
+)
+
+// renderedConsoleConfig mirrors just the fields of the generated
+// config.yaml this test needs to drive a kgo client and assert against,
+// not the full Console config schema.
+type renderedConsoleConfig struct {
+	Kafka struct {
+		Brokers []string `yaml:"brokers"`
+		TLS     struct {
+			Enabled bool `yaml:"enabled"`
+		} `yaml:"tls"`
+		SASL struct {
+			Enabled   bool   `yaml:"enabled"`
+			Username  string `yaml:"username"`
+			Password  string `yaml:"password"`
+			Mechanism string `yaml:"mechanism"`
+		} `yaml:"sasl"`
+	} `yaml:"kafka"`
+}
+
+func TestMain(m *testing.M) {
+	log.SetLogger(zap.New(zap.UseDevMode(true)))
+	m.Run()
+}
+
+// TestConfigMapAgainstLiveBroker covers {plaintext, TLS, TLS+SASL
+// SCRAM-SHA-256/512}: render the Console config for a fake Cluster/Console
+// pair pointed at a live broker started with matching settings, parse the
+// brokers/TLS/SASL settings back out of it to build a kgo client, use that
+// client to list topics, then independently verify the schema registry
+// listener came up with the TLS settings the Cluster requested.
+func TestConfigMapAgainstLiveBroker(t *testing.T) {
+	cases := []struct {
+		name     string
+		tls      bool
+		sasl     bool
+		saslUser string
+		saslMech string
+	}{
+		{name: "plaintext"},
+		{name: "tls", tls: true},
+		{name: "tls+sasl-scram-256", tls: true, sasl: true, saslUser: "console", saslMech: "SCRAM-SHA-256"},
+		{name: "tls+sasl-scram-512", tls: true, sasl: true, saslUser: "console", saslMech: "SCRAM-SHA-512"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+
+			dir := t.TempDir()
+			var certs tlsMaterial
+			if tc.tls {
+				var err error
+				certs, err = generateTLSMaterial(dir)
+				if err != nil {
+					t.Fatalf("generating TLS material: %v", err)
+				}
+			}
+
+			cfg := brokerConfig{
+				Dir:          dir,
+				TLSEnabled:   tc.tls,
+				SASLEnabled:  tc.sasl,
+				SASLUsername: tc.saslUser,
+			}
+			if _, err := writeRedpandaConfig(cfg); err != nil {
+				t.Fatalf("rendering redpanda.yaml: %v", err)
+			}
+
+			_, brokerHost, adminHost, schemaRegistryHost := startRedpanda(ctx, t, dir)
+
+			var caPool *x509.CertPool
+			if tc.tls {
+				caPool = x509.NewCertPool()
+				if !caPool.AppendCertsFromPEM(mustReadFile(t, certs.CaFile)) {
+					t.Fatal("failed to parse generated CA certificate")
+				}
+			}
+
+			if tc.sasl {
+				if err := provisionSASLUser(ctx, adminHost, tc.saslUser, testSASLPassword, tc.saslMech, caPool); err != nil {
+					t.Fatalf("provisioning SASL user: %v", err)
+				}
+			}
+
+			cluster := &redpandav1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			}
+			cluster.Spec.EnableSASL = tc.sasl
+			cluster.Spec.Configuration.TLS.KafkaAPI.Enabled = tc.tls
+			cluster.Spec.Configuration.TLS.SchemaRegistry.Enabled = tc.tls
+			cluster.Status.Nodes.Internal = []string{brokerHost}
+
+			console := &redpandav1alpha1.Console{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-console", Namespace: "default"},
+			}
+			console.Spec.Schema.Enabled = true
+			console.Spec.Kafka.SASL.Mechanism = tc.saslMech
+
+			scheme := runtime.NewScheme()
+			if err := clientgoscheme.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			if err := redpandav1alpha1.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, console).Build()
+			cm := consolepkg.NewConfigMap(fakeClient, scheme, console, cluster, log.Log)
+			if err := cm.Ensure(ctx); err != nil {
+				t.Fatalf("ConfigMap.Ensure: %v", err)
+			}
+
+			var generated corev1.ConfigMap
+			if err := fakeClient.Get(ctx, cm.Key(), &generated); err != nil {
+				t.Fatalf("fetching generated configmap: %v", err)
+			}
+			if generated.Data["config.yaml"] == "" {
+				t.Fatal("expected non-empty config.yaml")
+			}
+
+			var parsed renderedConsoleConfig
+			if err := yaml.Unmarshal([]byte(generated.Data["config.yaml"]), &parsed); err != nil {
+				t.Fatalf("parsing generated config.yaml: %v", err)
+			}
+
+			if !reflect.DeepEqual(parsed.Kafka.Brokers, []string{brokerHost}) {
+				t.Fatalf("genKafka brokers = %v, want [%s]", parsed.Kafka.Brokers, brokerHost)
+			}
+			if parsed.Kafka.TLS.Enabled != tc.tls {
+				t.Fatalf("genKafkaTLS.Enabled = %v, want %v", parsed.Kafka.TLS.Enabled, tc.tls)
+			}
+			if parsed.Kafka.SASL.Enabled != tc.sasl {
+				t.Fatalf("genSASL.Enabled = %v, want %v", parsed.Kafka.SASL.Enabled, tc.sasl)
+			}
+			if tc.sasl {
+				if parsed.Kafka.SASL.Username != expectedSASLUsernamePlaceholder || parsed.Kafka.SASL.Password != expectedSASLPasswordPlaceholder {
+					t.Fatalf("genSASL credentials = %q/%q, want placeholders %q/%q",
+						parsed.Kafka.SASL.Username, parsed.Kafka.SASL.Password,
+						expectedSASLUsernamePlaceholder, expectedSASLPasswordPlaceholder)
+				}
+			}
+
+			// The kgo client is built from what genKafka actually produced,
+			// not from tc directly, so a regression there fails this test.
+			opts := []kgo.Opt{kgo.SeedBrokers(parsed.Kafka.Brokers...)}
+			if parsed.Kafka.TLS.Enabled {
+				opts = append(opts, kgo.DialTLSConfig(&tls.Config{RootCAs: caPool}))
+			}
+			if parsed.Kafka.SASL.Enabled {
+				// The real credentials never appear in config.yaml (see the
+				// placeholder assertion above), so they're supplied here the
+				// same way the Console Deployment is expected to: out of band.
+				mechanism := scram.Auth{User: tc.saslUser, Pass: testSASLPassword}
+				switch parsed.Kafka.SASL.Mechanism {
+				case "SCRAM-SHA-256":
+					opts = append(opts, kgo.SASL(mechanism.AsSha256Mechanism()))
+				case "SCRAM-SHA-512":
+					opts = append(opts, kgo.SASL(mechanism.AsSha512Mechanism()))
+				default:
+					t.Fatalf("unexpected SASL mechanism %q in generated config", parsed.Kafka.SASL.Mechanism)
+				}
+			}
+			client, err := kgo.NewClient(opts...)
+			if err != nil {
+				t.Fatalf("creating kafka client: %v", err)
+			}
+			defer client.Close()
+
+			admin := kgo.NewAdmClient(client)
+			if _, err := admin.ListTopics(ctx); err != nil {
+				t.Fatalf("listing topics against live broker: %v", err)
+			}
+
+			// genSchemaRegistry's generated URL only resolves inside a real
+			// Console Deployment pod, so verify the schema registry TLS
+			// listener directly instead, matching the TLS tc requested.
+			if err := registerAndFetchSchema(ctx, schemaRegistryHost, caPool); err != nil {
+				t.Fatalf("registering/fetching schema: %v", err)
+			}
+		})
+	}
+}
+
+func startRedpanda(ctx context.Context, t *testing.T, configDir string) (container testcontainers.Container, brokerHost, adminHost, schemaRegistryHost string) {
+	t.Helper()
+
+	req := testcontainers.ContainerRequest{
+		Image:        redpandaImage,
+		ExposedPorts: []string{"9092/tcp", "9644/tcp", "8081/tcp"},
+		Cmd:          []string{"redpanda", "start", "--config", "/etc/redpanda/redpanda.yaml", "--overprovisioned", "--smp", "1"},
+		Mounts: testcontainers.ContainerMounts{
+			testcontainers.BindMount(configDir, "/etc/redpanda"),
+		},
+		WaitingFor: wait.ForListeningPort("9092/tcp").WithStartupTimeout(90 * time.Second),
+	}
+
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		t.Fatalf("starting redpanda container: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = c.Terminate(context.Background())
+	})
+
+	host, err := c.Host(ctx)
+	if err != nil {
+		t.Fatalf("getting container host: %v", err)
+	}
+	port, err := c.MappedPort(ctx, "9092")
+	if err != nil {
+		t.Fatalf("getting mapped kafka port: %v", err)
+	}
+	adminPort, err := c.MappedPort(ctx, "9644")
+	if err != nil {
+		t.Fatalf("getting mapped admin port: %v", err)
+	}
+	schemaRegistryPort, err := c.MappedPort(ctx, "8081")
+	if err != nil {
+		t.Fatalf("getting mapped schema registry port: %v", err)
+	}
+
+	return c, net.JoinHostPort(host, port.Port()), net.JoinHostPort(host, adminPort.Port()), net.JoinHostPort(host, schemaRegistryPort.Port())
+}
+
+// httpClientFor returns an http.Client trusting caPool, and the URL scheme
+// to use with it: "https" when caPool is set, "http" otherwise.
+func httpClientFor(caPool *x509.CertPool) (*http.Client, string) {
+	if caPool == nil {
+		return http.DefaultClient, "http"
+	}
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caPool}},
+	}, "https"
+}
+
+// provisionSASLUser creates username/password via the Admin API's
+// /v1/security/users endpoint. It does not grant superuser rights itself;
+// the user is a superuser through the "superusers" entry already baked into
+// redpanda.yaml.tmpl. Without this,
+// the broker knows the name as a superuser but has no credential to check
+// it against, so SASL auth would fail regardless of client-side wiring.
+func provisionSASLUser(ctx context.Context, adminHost, username, password, mechanism string, caPool *x509.CertPool) error {
+	httpClient, scheme := httpClientFor(caPool)
+
+	body, err := json.Marshal(struct {
+		Username  string `json:"username"`
+		Password  string `json:"password"`
+		Algorithm string `json:"algorithm"`
+	}{Username: username, Password: password, Algorithm: mechanism})
+	if err != nil {
+		return fmt.Errorf("encoding SASL user request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/v1/security/users", scheme, adminHost)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building SASL user request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("creating SASL user %q: %w", username, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("creating SASL user %q: unexpected status %s", username, resp.Status)
+	}
+	return nil
+}
+
+// testSchemaSubject and testSchema are a throwaway subject/schema pair used
+// only to prove the registry listener accepts TLS handshakes matching tc and
+// round-trips a schema - not to exercise any Console-specific behavior.
+const (
+	testSchemaSubject = "console-integration-test-value"
+	testSchema        = `{"type":"string"}`
+)
+
+// registerAndFetchSchema registers testSchema under testSchemaSubject
+// against the broker's schema registry listener, then fetches it back,
+// proving the listener actually came up with the TLS settings tc requested.
+func registerAndFetchSchema(ctx context.Context, registryHost string, caPool *x509.CertPool) error {
+	httpClient, scheme := httpClientFor(caPool)
+
+	body, err := json.Marshal(struct {
+		Schema string `json:"schema"`
+	}{Schema: testSchema})
+	if err != nil {
+		return fmt.Errorf("encoding schema: %w", err)
+	}
+
+	registerURL := fmt.Sprintf("%s://%s/subjects/%s/versions", scheme, registryHost, testSchemaSubject)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, registerURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building schema registration request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("registering schema: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registering schema: unexpected status %s", resp.Status)
+	}
+
+	fetchURL := fmt.Sprintf("%s://%s/subjects/%s/versions/latest", scheme, registryHost, testSchemaSubject)
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, fetchURL, nil)
+	if err != nil {
+		return fmt.Errorf("building schema fetch request: %w", err)
+	}
+
+	resp, err = httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetching schema: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching schema: unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func mustReadFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	return data
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+)
+
+func TestParseControllerVLevels(t *testing.T) {
+	cases := []struct {
+		name    string
+		flag    string
+		want    ControllerVLevels
+		wantErr bool
+	}{
+		{name: "empty", flag: "", want: ControllerVLevels{}},
+		{name: "single", flag: "Cluster=2", want: ControllerVLevels{"Cluster": 2}},
+		{name: "multiple", flag: "Cluster=2,Console=4", want: ControllerVLevels{"Cluster": 2, "Console": 4}},
+		{name: "missing equals", flag: "Cluster", wantErr: true},
+		{name: "non-numeric level", flag: "Cluster=high", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseControllerVLevels(tc.flag)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for flag %q, got nil", tc.flag)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseControllerVLevels(%q): %v", tc.flag, err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+			for name, level := range tc.want {
+				if got[name] != level {
+					t.Errorf("got[%q] = %d, want %d", name, got[name], level)
+				}
+			}
+		})
+	}
+}
+
+func TestLoggerForWithoutOverrideReturnsBaseSink(t *testing.T) {
+	base := funcr.New(func(prefix, args string) {}, funcr.Options{Verbosity: 1})
+
+	log := LoggerFor(base, "Cluster", ControllerVLevels{})
+
+	if log.GetSink().Enabled(2) {
+		t.Fatal("expected V(2) to be disabled without an override, matching the base verbosity")
+	}
+	if !log.GetSink().Enabled(1) {
+		t.Fatal("expected V(1) to be enabled, matching the base verbosity")
+	}
+}
+
+func TestLoggerForWithOverrideIgnoresBaseVerbosity(t *testing.T) {
+	base := funcr.New(func(prefix, args string) {}, funcr.Options{Verbosity: 0})
+
+	log := LoggerFor(base, "Console", ControllerVLevels{"Console": 4})
+
+	if !log.GetSink().Enabled(4) {
+		t.Fatal("expected V(4) to be enabled under the Console=4 override")
+	}
+	if log.GetSink().Enabled(5) {
+		t.Fatal("expected V(5) to be disabled above the Console=4 override")
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package syncjobs runs leader-elected, cron-like background jobs that
+// re-reconcile external state the event-driven controllers can miss, such
+// as an Issuer rotating its root out-of-band or a Kafka ACL being deleted
+// manually.
+package syncjobs
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// PKISyncJob re-reconciles cert-manager-issued PKI material.
+	PKISyncJob = "pki-sync"
+	// ConsoleACLSyncJob re-reconciles Console's Kafka ServiceAccount/ACLs.
+	ConsoleACLSyncJob = "console-acl-sync"
+	// NodeCertExpiryCheckJob checks node certificate expiry independent of
+	// cert-manager's own renewal watch.
+	NodeCertExpiryCheckJob = "node-cert-expiry-check"
+)
+
+var (
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "redpanda_operator_sync_job_duration_seconds",
+		Help: "Duration of a scheduled sync job run, in seconds.",
+	}, []string{"job"})
+
+	jobFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "redpanda_operator_sync_job_failures_total",
+		Help: "Total number of failed scheduled sync job runs.",
+	}, []string{"job"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(jobDuration, jobFailures)
+}
+
+// JobFunc is the work performed by a scheduled job on each tick.
+type JobFunc func(ctx context.Context) error
+
+// Job is a single named, periodically-run task.
+type Job struct {
+	// Name identifies the job in logs and metrics, e.g. PKISyncJob.
+	Name string
+	// Interval is the nominal time between runs.
+	Interval time.Duration
+	// Jitter is a random duration, up to this value, added to Interval (and
+	// used as the delay before the first run) so that jobs registered
+	// together don't all tick in lockstep.
+	Jitter time.Duration
+	// Timeout bounds a single run of Func.
+	Timeout time.Duration
+	// Func is invoked on each tick.
+	Func JobFunc
+}
+
+// Scheduler runs a set of registered Jobs on their own interval for as long
+// as this process holds the leader lease. It implements
+// manager.LeaderElectionRunnable so the controller-runtime Manager only
+// starts it once this replica becomes leader.
+type Scheduler struct {
+	jobs []Job
+	log  logr.Logger
+}
+
+// NewScheduler creates an empty Scheduler. Jobs are registered via Register.
+func NewScheduler(log logr.Logger) *Scheduler {
+	return &Scheduler{log: log.WithName("syncjobs")}
+}
+
+// Register adds a job to the scheduler. It must be called before the
+// Scheduler is started by the Manager.
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (s *Scheduler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. It blocks until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	for _, job := range s.jobs {
+		go s.run(ctx, job)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	log := s.log.WithValues("job", job.Name)
+	// Stagger the first tick so jobs registered together don't all fire at
+	// once on startup.
+	timer := time.NewTimer(jitter(job.Jitter))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			s.runOnce(ctx, job, log)
+			timer.Reset(job.Interval + jitter(job.Jitter))
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job, log logr.Logger) {
+	runCtx := ctx
+	var cancel context.CancelFunc
+	if job.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := job.Func(runCtx)
+	jobDuration.WithLabelValues(job.Name).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		jobFailures.WithLabelValues(job.Name).Inc()
+		log.Error(err, "Sync job failed")
+		return
+	}
+	log.V(1).Info("Sync job completed", "duration", time.Since(start))
+}
+
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+var _ manager.LeaderElectionRunnable = &Scheduler{}
+var _ manager.Runnable = &Scheduler{}
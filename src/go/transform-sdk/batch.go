@@ -0,0 +1,151 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redpanda
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/redpanda-data/redpanda/src/go/transform-sdk/internal/rwbuf"
+)
+
+// BatchWriter encodes a sequence of Records so a transform can emit
+// multiple output records into a single buffer instead of paying the
+// overhead of one buffer per record. Records are staged internally and
+// only written to the destination buffer once Flush is called, so a single
+// CRC32 can cover the whole batch.
+//
+// Wire format written by Flush:
+//
+//	uint32 totalLength  (big endian, length of the encoded records below)
+//	uint32 crc32        (big endian, IEEE CRC32 of the encoded records below)
+//	repeated, back to back:
+//	  uint32 recordLength (big endian)
+//	  [recordLength]byte  (the serialized record)
+type BatchWriter struct {
+	staged *rwbuf.RWBuf
+	count  int
+}
+
+// NewBatchWriter creates an empty BatchWriter.
+func NewBatchWriter() *BatchWriter {
+	return &BatchWriter{staged: rwbuf.New(0)}
+}
+
+// WriteRecord serializes r and stages it for the next Flush.
+func (w *BatchWriter) WriteRecord(r Record) error {
+	record := rwbuf.New(0)
+	r.serialize(record)
+	payload := record.Bytes()
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(payload)))
+
+	if _, err := w.staged.Write(length[:]); err != nil {
+		return fmt.Errorf("staging batch record length: %w", err)
+	}
+	if _, err := w.staged.Write(payload); err != nil {
+		return fmt.Errorf("staging batch record: %w", err)
+	}
+	w.count++
+	return nil
+}
+
+// Len returns the number of records staged since the last Flush.
+func (w *BatchWriter) Len() int {
+	return w.count
+}
+
+// Flush appends the framed, CRC-checked batch of staged records to out and
+// resets the writer so it can be reused for the next batch.
+func (w *BatchWriter) Flush(out *rwbuf.RWBuf) error {
+	records := w.staged.Bytes()
+
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(records)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(records))
+
+	if _, err := out.Write(header[:]); err != nil {
+		return fmt.Errorf("writing batch header: %w", err)
+	}
+	if _, err := out.Write(records); err != nil {
+		return fmt.Errorf("writing batch records: %w", err)
+	}
+
+	w.staged = rwbuf.New(0)
+	w.count = 0
+	return nil
+}
+
+// BatchReader decodes a sequence of Records previously written by a
+// BatchWriter's Flush.
+type BatchReader struct {
+	records *rwbuf.RWBuf
+}
+
+// NewBatchReader reads and CRC-validates one batch header from in, and
+// returns a BatchReader that yields the records it contains.
+func NewBatchReader(in *rwbuf.RWBuf) (*BatchReader, error) {
+	var header [8]byte
+	if _, err := in.Read(header[:]); err != nil {
+		return nil, fmt.Errorf("reading batch header: %w", err)
+	}
+	totalLength := binary.BigEndian.Uint32(header[0:4])
+	wantCRC := binary.BigEndian.Uint32(header[4:8])
+
+	if remaining := in.Len(); totalLength > uint32(remaining) {
+		return nil, fmt.Errorf("batch length %d exceeds %d remaining bytes", totalLength, remaining)
+	}
+
+	records := make([]byte, totalLength)
+	if _, err := in.Read(records); err != nil {
+		return nil, fmt.Errorf("reading batch records: %w", err)
+	}
+
+	if gotCRC := crc32.ChecksumIEEE(records); gotCRC != wantCRC {
+		return nil, fmt.Errorf("batch crc mismatch: got %#x, want %#x", gotCRC, wantCRC)
+	}
+
+	return &BatchReader{records: rwbuf.NewFromBytes(records)}, nil
+}
+
+// ReadRecord decodes the next Record in the batch. It returns false once
+// every record in the batch has been consumed.
+func (r *BatchReader) ReadRecord() (Record, bool, error) {
+	if r.records.Len() == 0 {
+		return Record{}, false, nil
+	}
+
+	var lengthBytes [4]byte
+	if _, err := r.records.Read(lengthBytes[:]); err != nil {
+		return Record{}, false, fmt.Errorf("reading record length: %w", err)
+	}
+	length := binary.BigEndian.Uint32(lengthBytes[:])
+	if remaining := r.records.Len(); length > uint32(remaining) {
+		return Record{}, false, fmt.Errorf("record length %d exceeds %d remaining bytes in batch", length, remaining)
+	}
+
+	payload := make([]byte, length)
+	if _, err := r.records.Read(payload); err != nil {
+		return Record{}, false, fmt.Errorf("reading record payload: %w", err)
+	}
+
+	out := Record{}
+	if err := out.deserialize(rwbuf.NewFromBytes(payload)); err != nil {
+		return Record{}, false, fmt.Errorf("deserializing record: %w", err)
+	}
+	return out, true, nil
+}
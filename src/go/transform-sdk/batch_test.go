@@ -0,0 +1,138 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redpanda
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"reflect"
+	"testing"
+
+	"github.com/redpanda-data/redpanda/src/go/transform-sdk/internal/rwbuf"
+)
+
+func TestBatchRoundTrip(t *testing.T) {
+	records := []Record{makeRandomRecord(), makeRandomRecord(), makeRandomRecord()}
+
+	w := NewBatchWriter()
+	for _, r := range records {
+		if err := w.WriteRecord(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if w.Len() != len(records) {
+		t.Fatalf("expected %d staged records, got %d", len(records), w.Len())
+	}
+
+	b := rwbuf.New(0)
+	if err := w.Flush(b); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewBatchReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output []Record
+	for {
+		r, ok, err := reader.ReadRecord()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+		output = append(output, r)
+	}
+
+	if !reflect.DeepEqual(records, output) {
+		t.Fatalf("%#v != %#v", records, output)
+	}
+}
+
+func TestBatchRoundTripEmpty(t *testing.T) {
+	w := NewBatchWriter()
+	b := rwbuf.New(0)
+	if err := w.Flush(b); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewBatchReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok, err := reader.ReadRecord()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected no records in an empty batch")
+	}
+}
+
+// TestBatchReaderRejectsCorruptLength ensures a corrupted/malicious 32-bit
+// batch length is rejected against the bytes actually available instead of
+// being handed straight to make([]byte, ...), which would otherwise attempt
+// a multi-GB allocation on a few corrupted header bytes.
+func TestBatchReaderRejectsCorruptLength(t *testing.T) {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:4], 0xFFFFFFFF)
+	binary.BigEndian.PutUint32(header[4:8], 0)
+
+	b := rwbuf.New(0)
+	if _, err := b.Write(header[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewBatchReader(b); err == nil {
+		t.Fatal("expected an error for a batch length exceeding the available bytes")
+	}
+}
+
+// TestBatchReaderRejectsCorruptRecordLength is the ReadRecord analogue of
+// TestBatchReaderRejectsCorruptLength: a single record's length field is
+// corrupted, not the batch header.
+func TestBatchReaderRejectsCorruptRecordLength(t *testing.T) {
+	var recordLength [4]byte
+	binary.BigEndian.PutUint32(recordLength[:], 0xFFFFFFFF)
+
+	body := rwbuf.New(0)
+	if _, err := body.Write(recordLength[:]); err != nil {
+		t.Fatal(err)
+	}
+	records := body.Bytes()
+
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(records)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(records))
+
+	b := rwbuf.New(0)
+	if _, err := b.Write(header[:]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Write(records); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewBatchReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := reader.ReadRecord(); err == nil {
+		t.Fatal("expected an error for a record length exceeding the available bytes")
+	}
+}
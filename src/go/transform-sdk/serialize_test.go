@@ -82,3 +82,39 @@ func TestRoundTrip(t *testing.T) {
 		t.Fatalf("%#v != %#v", r, output)
 	}
 }
+
+// FuzzRecordRoundTrip hardens the wire format against pathological inputs:
+// zero-length keys/values, many/no headers, negative timestamps, and
+// offsets/timestamps near the varint boundaries.
+func FuzzRecordRoundTrip(f *testing.F) {
+	f.Add([]byte{}, []byte{}, 0, int64(0), int64(0))
+	f.Add([]byte("key"), []byte("value"), 4, int64(-1), int64(-1))
+	f.Add([]byte{}, make([]byte, 256), 64, int64(1<<63-1), int64(-1<<63))
+
+	f.Fuzz(func(t *testing.T, key, value []byte, numHeaders int, timestampMillis, offset int64) {
+		if numHeaders < 0 {
+			numHeaders = -numHeaders
+		}
+		numHeaders %= 64
+
+		r := Record{
+			Key:       key,
+			Value:     value,
+			Attrs:     RecordAttrs{0},
+			Headers:   makeRandomHeaders(numHeaders),
+			Timestamp: time.UnixMilli(timestampMillis),
+			Offset:    offset,
+		}
+
+		b := rwbuf.New(0)
+		r.serialize(b)
+
+		output := Record{}
+		if err := output.deserialize(b); err != nil {
+			t.Fatalf("deserialize: %v", err)
+		}
+		if !reflect.DeepEqual(r, output) {
+			t.Fatalf("%#v != %#v", r, output)
+		}
+	})
+}